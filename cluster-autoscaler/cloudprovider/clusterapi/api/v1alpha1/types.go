@@ -0,0 +1,110 @@
+/*
+Copyright 2026 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// Package v1alpha1 contains the typed AutoscalerNodeGroupConfig API, an
+// alternative to annotation-driven configuration of the clusterapi cloud
+// provider's node groups.
+package v1alpha1
+
+import (
+	apiv1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+// +k8s:deepcopy-gen:interfaces=k8s.io/apimachinery/pkg/runtime.Object
+
+// AutoscalerNodeGroupConfig is a cluster-scoped resource that attaches
+// typed scaling and capacity configuration to a MachineSet or
+// MachineDeployment named by Spec.TargetRef, as an alternative to
+// annotations on the target object itself.
+type AutoscalerNodeGroupConfig struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	Spec AutoscalerNodeGroupConfigSpec `json:"spec"`
+}
+
+// NodeGroupReference identifies a namespaced MachineSet or
+// MachineDeployment that an AutoscalerNodeGroupConfig targets.
+type NodeGroupReference struct {
+	// Kind is "MachineSet" or "MachineDeployment".
+	Kind string `json:"kind"`
+
+	// Namespace is the namespace of the target object.
+	Namespace string `json:"namespace"`
+
+	// Name is the name of the target object.
+	Name string `json:"name"`
+}
+
+// AutoscalerNodeGroupConfigSpec is the typed equivalent of the
+// annotation-driven node group configuration recognized elsewhere in the
+// clusterapi provider.
+type AutoscalerNodeGroupConfigSpec struct {
+	// TargetRef identifies the namespaced MachineSet or
+	// MachineDeployment this configuration applies to. AutoscalerNodeGroupConfig
+	// is cluster-scoped, so TargetRef carries the target's namespace
+	// explicitly; Namespace+Kind+Name together must be unique for the
+	// lookup performed by the provider to be unambiguous.
+	TargetRef NodeGroupReference `json:"targetRef"`
+
+	// MinReplicas and MaxReplicas are the typed equivalents of the
+	// nodeGroupMinSizeAnnotationKey/nodeGroupMaxSizeAnnotationKey
+	// annotations. A nil field falls through to annotation parsing for
+	// that bound specifically, rather than being treated as an explicit
+	// zero; this lets a config set only one of the two bounds, or only
+	// Capacity/Labels/Taints, without disturbing the other.
+	// +optional
+	MinReplicas *int32 `json:"minReplicas,omitempty"`
+	// +optional
+	MaxReplicas *int32 `json:"maxReplicas,omitempty"`
+
+	// Capacity is the typed equivalent of the cpuKey/memoryKey/gpuKey/
+	// maxPodsKey/ephemeralStorageKey/extendedResourcesKey annotations. A
+	// resource name present in Capacity takes precedence over that
+	// resource's annotation; a resource name absent from a nil or
+	// non-nil Capacity falls through to annotation parsing for that
+	// resource only.
+	// +optional
+	Capacity apiv1.ResourceList `json:"capacity,omitempty"`
+
+	// Labels is the typed equivalent of the labelsKey annotation. A nil
+	// map falls through to annotation parsing rather than being treated
+	// as an explicit empty set of labels.
+	// +optional
+	Labels map[string]string `json:"labels,omitempty"`
+
+	// Taints is the typed equivalent of the taintsKey annotation. A nil
+	// slice falls through to annotation parsing rather than being
+	// treated as an explicit empty set of taints.
+	// +optional
+	Taints []apiv1.Taint `json:"taints,omitempty"`
+
+	// ScaleFromZero is the typed equivalent of scaleFromZeroEnabled. A
+	// nil field falls through to annotation parsing rather than being
+	// treated as an explicit false, so that a config created only to set
+	// Capacity/Labels/Taints doesn't silently disable scale-from-zero.
+	// +optional
+	ScaleFromZero *bool `json:"scaleFromZero,omitempty"`
+}
+
+// AutoscalerNodeGroupConfigList is a list of AutoscalerNodeGroupConfig.
+type AutoscalerNodeGroupConfigList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata,omitempty"`
+
+	Items []AutoscalerNodeGroupConfig `json:"items"`
+}
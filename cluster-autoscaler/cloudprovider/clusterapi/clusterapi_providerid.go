@@ -0,0 +1,133 @@
+/*
+Copyright 2026 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package clusterapi
+
+import (
+	"fmt"
+	"net/url"
+	"strings"
+)
+
+// ProviderIDNormalizer extracts the identifier cluster-autoscaler uses to
+// match a cloud provider's Machine objects to Kubernetes Nodes from a
+// providerID URI. Out-of-tree providers register their own
+// implementation with RegisterProviderIDNormalizer so that Machine/Node
+// matching works correctly on platforms whose providerID format isn't
+// "<scheme>://.../<instance-id>".
+type ProviderIDNormalizer interface {
+	// Normalize returns the normalized form of providerID.
+	Normalize(providerID string) normalizedProviderID
+}
+
+// ProviderIDNormalizerFunc adapts a function to a ProviderIDNormalizer.
+type ProviderIDNormalizerFunc func(providerID string) normalizedProviderID
+
+// Normalize implements ProviderIDNormalizer.
+func (f ProviderIDNormalizerFunc) Normalize(providerID string) normalizedProviderID {
+	return f(providerID)
+}
+
+// lastSegmentNormalizer splits providerID on '/' and returns everything
+// after the last '/'. It is correct for providers whose providerID ends
+// in the instance identifier, e.g. AWS's "aws:///<az>/<instance-id>".
+func lastSegmentNormalizer(providerID string) normalizedProviderID {
+	split := strings.Split(providerID, "/")
+	return normalizedProviderID(split[len(split)-1])
+}
+
+// hostNormalizer treats the URI host (and, for providers that pack the
+// identifier as host plus path segments, only the host) as the
+// identifier, e.g. vSphere's "vsphere://<uuid>" or metal3's
+// "metal3://<bmh-uuid>/<namespace>/<name>".
+func hostNormalizer(providerID string) normalizedProviderID {
+	u, err := url.Parse(providerID)
+	if err != nil || u.Host == "" {
+		return lastSegmentNormalizer(providerID)
+	}
+	return normalizedProviderID(u.Host)
+}
+
+// builtinProviderIDNormalizers are the normalizers this provider ships
+// with, keyed by the URI scheme of a Node's spec.providerID.
+var builtinProviderIDNormalizers = map[string]ProviderIDNormalizer{
+	"aws":       ProviderIDNormalizerFunc(lastSegmentNormalizer),
+	"gce":       ProviderIDNormalizerFunc(lastSegmentNormalizer),
+	"azure":     ProviderIDNormalizerFunc(lastSegmentNormalizer),
+	"vsphere":   ProviderIDNormalizerFunc(hostNormalizer),
+	"openstack": ProviderIDNormalizerFunc(lastSegmentNormalizer),
+	"metal3":    ProviderIDNormalizerFunc(hostNormalizer),
+	"generic":   ProviderIDNormalizerFunc(lastSegmentNormalizer),
+}
+
+// providerIDNormalizers is the active registry consulted by
+// normalizedProviderString, seeded from builtinProviderIDNormalizers and
+// mutated by RegisterProviderIDNormalizer and
+// ParseProviderIDNormalizerFlag.
+var providerIDNormalizers = cloneNormalizerRegistry(builtinProviderIDNormalizers)
+
+func cloneNormalizerRegistry(src map[string]ProviderIDNormalizer) map[string]ProviderIDNormalizer {
+	dst := make(map[string]ProviderIDNormalizer, len(src))
+	for scheme, normalizer := range src {
+		dst[scheme] = normalizer
+	}
+	return dst
+}
+
+// RegisterProviderIDNormalizer registers normalizer as the
+// ProviderIDNormalizer for the given URI scheme, overriding any built-in
+// normalizer already registered for that scheme. Out-of-tree cloud
+// providers call this from an init function to teach cluster-autoscaler
+// how to match their Machines to Nodes.
+func RegisterProviderIDNormalizer(scheme string, normalizer ProviderIDNormalizer) {
+	providerIDNormalizers[scheme] = normalizer
+}
+
+// ParseProviderIDNormalizerFlag parses a single --providerid-normalizer
+// value of the form "<scheme>=<impl>", where impl names one of the
+// built-in normalizers (aws, gce, azure, vsphere, openstack, metal3,
+// generic), and registers it for scheme. This lets operators work around
+// a provider whose providerID format doesn't match its scheme's default,
+// without a code change.
+func ParseProviderIDNormalizerFlag(value string) error {
+	scheme, impl, found := strings.Cut(value, "=")
+	if !found || scheme == "" || impl == "" {
+		return fmt.Errorf("invalid --providerid-normalizer value %q, expected <scheme>=<impl>", value)
+	}
+
+	normalizer, ok := builtinProviderIDNormalizers[impl]
+	if !ok {
+		return fmt.Errorf("unknown providerid-normalizer implementation %q", impl)
+	}
+
+	RegisterProviderIDNormalizer(scheme, normalizer)
+	return nil
+}
+
+// normalizedProviderString dispatches to the ProviderIDNormalizer
+// registered for s's URI scheme, falling back to the generic
+// last-segment behaviour for unknown or missing schemes.
+func normalizedProviderString(s string) normalizedProviderID {
+	scheme, _, found := strings.Cut(s, "://")
+	if !found {
+		return providerIDNormalizers["generic"].Normalize(s)
+	}
+
+	if normalizer, ok := providerIDNormalizers[scheme]; ok {
+		return normalizer.Normalize(s)
+	}
+	return providerIDNormalizers["generic"].Normalize(s)
+}
@@ -0,0 +1,104 @@
+/*
+Copyright 2026 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package clusterapi
+
+import (
+	"testing"
+)
+
+// withTestProviderIDNormalizers installs a fresh clone of
+// builtinProviderIDNormalizers for the duration of the test, so that
+// RegisterProviderIDNormalizer/ParseProviderIDNormalizerFlag calls don't
+// leak between tests.
+func withTestProviderIDNormalizers(t *testing.T) {
+	t.Helper()
+
+	old := providerIDNormalizers
+	providerIDNormalizers = cloneNormalizerRegistry(builtinProviderIDNormalizers)
+	t.Cleanup(func() { providerIDNormalizers = old })
+}
+
+func TestNormalizedProviderStringDispatchesByScheme(t *testing.T) {
+	withTestProviderIDNormalizers(t)
+
+	cases := []struct {
+		name       string
+		providerID string
+		want       normalizedProviderID
+	}{
+		{"aws last segment", "aws:///us-east-1a/i-0123456789", "i-0123456789"},
+		{"vsphere host", "vsphere://423d2333-f1ba-4d42-9da10", "423d2333-f1ba-4d42-9da10"},
+		{"unknown scheme falls back to generic", "unknown-cloud://some-instance-id", "some-instance-id"},
+		{"no scheme falls back to generic", "bare-instance-id", "bare-instance-id"},
+	}
+
+	for _, c := range cases {
+		t.Run(c.name, func(t *testing.T) {
+			if got := normalizedProviderString(c.providerID); got != c.want {
+				t.Errorf("normalizedProviderString(%q) = %q, want %q", c.providerID, got, c.want)
+			}
+		})
+	}
+}
+
+func TestParseProviderIDNormalizerFlagRegistersNormalizer(t *testing.T) {
+	withTestProviderIDNormalizers(t)
+
+	if err := ParseProviderIDNormalizerFlag("example=vsphere"); err != nil {
+		t.Fatalf("ParseProviderIDNormalizerFlag() returned error: %v", err)
+	}
+
+	got := normalizedProviderString("example://00000000-0000-0000-0000-000000000000")
+	want := normalizedProviderID("00000000-0000-0000-0000-000000000000")
+	if got != want {
+		t.Errorf("normalizedProviderString() = %q after registering example=vsphere, want %q", got, want)
+	}
+}
+
+func TestParseProviderIDNormalizerFlagErrors(t *testing.T) {
+	withTestProviderIDNormalizers(t)
+
+	cases := []struct {
+		name  string
+		value string
+	}{
+		{"missing equals", "examplevsphere"},
+		{"empty scheme", "=vsphere"},
+		{"empty impl", "example="},
+		{"unknown impl", "example=not-a-real-implementation"},
+	}
+
+	for _, c := range cases {
+		t.Run(c.name, func(t *testing.T) {
+			if err := ParseProviderIDNormalizerFlag(c.value); err == nil {
+				t.Errorf("ParseProviderIDNormalizerFlag(%q) returned no error", c.value)
+			}
+		})
+	}
+}
+
+func TestRegisterProviderIDNormalizerOverridesBuiltin(t *testing.T) {
+	withTestProviderIDNormalizers(t)
+
+	RegisterProviderIDNormalizer("aws", ProviderIDNormalizerFunc(func(providerID string) normalizedProviderID {
+		return "overridden"
+	}))
+
+	if got := normalizedProviderString("aws:///us-east-1a/i-0123456789"); got != "overridden" {
+		t.Errorf("normalizedProviderString() = %q, want the overridden normalizer's result", got)
+	}
+}
@@ -22,10 +22,14 @@ import (
 	"strings"
 
 	"github.com/pkg/errors"
+	apiv1 "k8s.io/api/core/v1"
 	"k8s.io/apimachinery/pkg/api/resource"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	nodegroupconfigv1alpha1 "k8s.io/autoscaler/cluster-autoscaler/cloudprovider/clusterapi/api/v1alpha1"
+	"k8s.io/autoscaler/cluster-autoscaler/cloudprovider/clusterapi/resourcemodel"
 	"k8s.io/autoscaler/cluster-autoscaler/utils/units"
+	"sigs.k8s.io/yaml"
 )
 
 const (
@@ -33,10 +37,39 @@ const (
 	deprecatedNodeGroupMaxSizeAnnotationKey = "cluster.k8s.io/cluster-api-autoscaler-node-group-max-size"
 	deprecatedClusterNameLabel              = "cluster.k8s.io/cluster-name"
 
-	cpuKey     = "machine.openshift.io/vCPU"
-	memoryKey  = "machine.openshift.io/memoryMb"
-	gpuKey     = "machine.openshift.io/GPU"
-	maxPodsKey = "machine.openshift.io/maxPods"
+	cpuKey              = "machine.openshift.io/vCPU"
+	memoryKey           = "machine.openshift.io/memoryMb"
+	gpuKey              = "machine.openshift.io/GPU"
+	maxPodsKey          = "machine.openshift.io/maxPods"
+	ephemeralStorageKey = "machine.openshift.io/ephemeral-storage"
+
+	// extendedResourcesKey names an annotation carrying a JSON/YAML map
+	// of resource-name to resource.Quantity for resources that don't
+	// have a dedicated annotation key of their own, e.g.
+	// {"nvidia.com/mig-1g.5gb":"4","hugepages-2Mi":"2Gi"}.
+	extendedResourcesKey = "capacity.cluster-autoscaler.kubernetes.io/resources"
+
+	// resourceModelKey names a tier in the resource model catalog that
+	// this MachineSet/MachineDeployment's capacity should be resolved
+	// from, as an alternative to setting cpuKey/memoryKey/etc directly.
+	resourceModelKey = "cluster.x-k8s.io/resource-model"
+
+	labelsKey = "capacity.cluster-autoscaler.kubernetes.io/labels"
+	taintsKey = "capacity.cluster-autoscaler.kubernetes.io/taints"
+	archKey   = "capacity.cluster-autoscaler.kubernetes.io/architecture"
+	osKey     = "capacity.cluster-autoscaler.kubernetes.io/os"
+	zoneKey   = "capacity.cluster-autoscaler.kubernetes.io/zone"
+	regionKey = "capacity.cluster-autoscaler.kubernetes.io/region"
+
+	// priorityClassNamesKey names an annotation carrying a
+	// comma-separated list of pod PriorityClassNames this node group is
+	// intended to serve, e.g. "high-priority,batch".
+	priorityClassNamesKey = "cluster.x-k8s.io/priority-class-names"
+
+	// qosClassKey names an annotation carrying a comma-separated list of
+	// pod QoS classes this node group is intended to serve, e.g.
+	// "Guaranteed,Burstable".
+	qosClassKey = "cluster.x-k8s.io/qos-class"
 )
 
 var (
@@ -63,9 +96,78 @@ var (
 	// machine set has a non-integral max annotation value.
 	errInvalidMaxAnnotation = errors.New("invalid max annotation")
 
+	// errInvalidLabelsAnnotation is the error returned when the labelsKey
+	// annotation cannot be parsed as a comma-separated list of key=value
+	// pairs.
+	errInvalidLabelsAnnotation = errors.New("invalid labels annotation")
+
+	// errInvalidTaintsAnnotation is the error returned when the
+	// taintsKey annotation cannot be parsed as a comma-separated list of
+	// key=value:Effect entries.
+	errInvalidTaintsAnnotation = errors.New("invalid taints annotation")
+
+	// errInvalidQoSClassAnnotation is the error returned when the
+	// qosClassKey annotation names something other than Guaranteed,
+	// Burstable or BestEffort.
+	errInvalidQoSClassAnnotation = errors.New("invalid qos-class annotation")
+
 	zeroQuantity = resource.MustParse("0")
+
+	// resourceModelCatalog is the catalog of named resource tiers that
+	// resourceModelKey annotations are resolved against. It is nil until
+	// SetResourceModelCatalog is called during provider start-up, in
+	// which case resourceModelKey annotations are ignored.
+	resourceModelCatalog *resourcemodel.Catalog
 )
 
+// SetResourceModelCatalog installs the catalog used to resolve
+// resourceModelKey annotations, loaded from a ConfigMap or the
+// --resource-model-config flag.
+func SetResourceModelCatalog(c *resourcemodel.Catalog) {
+	resourceModelCatalog = c
+}
+
+// NodeGroupConfigLister looks up the typed AutoscalerNodeGroupConfig
+// targeting a namespaced MachineSet/MachineDeployment, e.g. backed by a
+// controller-runtime informer cache. Namespace and kind are required
+// alongside name because MachineSets/MachineDeployments are namespaced
+// even though AutoscalerNodeGroupConfig itself is cluster-scoped, and a
+// MachineSet and a MachineDeployment can share a namespace and name.
+type NodeGroupConfigLister interface {
+	Get(targetNamespace, targetKind, targetName string) (*nodegroupconfigv1alpha1.AutoscalerNodeGroupConfig, bool)
+}
+
+// nodeGroupConfigLister is consulted by parseScalingBounds and
+// scaleFromZeroEnabled before they fall back to annotation parsing. It is
+// nil until SetNodeGroupConfigLister is called during provider start-up.
+var nodeGroupConfigLister NodeGroupConfigLister
+
+// SetNodeGroupConfigLister installs the informer-backed lister used to
+// resolve AutoscalerNodeGroupConfig objects ahead of annotation parsing.
+func SetNodeGroupConfigLister(l NodeGroupConfigLister) {
+	nodeGroupConfigLister = l
+}
+
+// resolveResourceModel returns the quantity for resourceName from the
+// tier named by the resourceModelKey annotation. It returns a zero
+// quantity if no model is named or no catalog has been configured.
+func resolveResourceModel(annotations map[string]string, resourceName apiv1.ResourceName) (resource.Quantity, error) {
+	name := annotations[resourceModelKey]
+	if name == "" || resourceModelCatalog == nil {
+		return zeroQuantity.DeepCopy(), nil
+	}
+
+	list, err := resourceModelCatalog.Resolve(name)
+	if err != nil {
+		return zeroQuantity.DeepCopy(), err
+	}
+
+	if q, ok := list[resourceName]; ok {
+		return q, nil
+	}
+	return zeroQuantity.DeepCopy(), nil
+}
+
 type normalizedProviderID string
 
 // minSize returns the minimum value encoded in the annotations keyed
@@ -100,23 +202,37 @@ func maxSize(annotations map[string]string) (int, error) {
 	return i, nil
 }
 
-func parseScalingBounds(annotations map[string]string) (int, int, error) {
-	minSize, err := minSize(annotations)
-	if err != nil && err != errMissingMinAnnotation {
-		return 0, 0, err
-	}
-
-	if minSize < 0 {
-		return 0, 0, errInvalidMinAnnotation
+// resolveNodeGroupConfig returns the AutoscalerNodeGroupConfig targeting
+// namespace/kind/name from nodeGroupConfigLister, or nil if no lister is
+// installed or none targets this group. kind is one of machineSetKind or
+// machineDeploymentKind, and disambiguates a MachineSet from a
+// MachineDeployment that happen to share a namespace and name.
+func resolveNodeGroupConfig(namespace, kind, name string) *nodegroupconfigv1alpha1.AutoscalerNodeGroupConfig {
+	if nodeGroupConfigLister == nil {
+		return nil
 	}
+	cfg, _ := nodeGroupConfigLister.Get(namespace, kind, name)
+	return cfg
+}
 
-	maxSize, err := maxSize(annotations)
-	if err != nil && err != errMissingMaxAnnotation {
+// parseScalingBounds returns the min/max replica bounds for the named
+// MachineSet/MachineDeployment in namespace. If an AutoscalerNodeGroupConfig
+// targeting namespace/kind/name is available from nodeGroupConfigLister,
+// its typed MinReplicas/MaxReplicas each take precedence over the
+// corresponding annotation when set; a field left nil falls through to
+// annotation parsing for that bound only, so a config that doesn't set
+// both bounds can't silently zero the one it leaves unset.
+func parseScalingBounds(namespace, kind, name string, annotations map[string]string) (int, int, error) {
+	cfg := resolveNodeGroupConfig(namespace, kind, name)
+
+	minSize, err := resolveMinSize(cfg, annotations)
+	if err != nil {
 		return 0, 0, err
 	}
 
-	if maxSize < 0 {
-		return 0, 0, errInvalidMaxAnnotation
+	maxSize, err := resolveMaxSize(cfg, annotations)
+	if err != nil {
+		return 0, 0, err
 	}
 
 	if maxSize < minSize {
@@ -126,6 +242,48 @@ func parseScalingBounds(annotations map[string]string) (int, int, error) {
 	return minSize, maxSize, nil
 }
 
+// resolveMinSize returns cfg.Spec.MinReplicas if cfg targets this group
+// and sets it, otherwise the min annotation.
+func resolveMinSize(cfg *nodegroupconfigv1alpha1.AutoscalerNodeGroupConfig, annotations map[string]string) (int, error) {
+	if cfg != nil && cfg.Spec.MinReplicas != nil {
+		min := int(*cfg.Spec.MinReplicas)
+		if min < 0 {
+			return 0, errInvalidMinAnnotation
+		}
+		return min, nil
+	}
+
+	min, err := minSize(annotations)
+	if err != nil && err != errMissingMinAnnotation {
+		return 0, err
+	}
+	if min < 0 {
+		return 0, errInvalidMinAnnotation
+	}
+	return min, nil
+}
+
+// resolveMaxSize returns cfg.Spec.MaxReplicas if cfg targets this group
+// and sets it, otherwise the max annotation.
+func resolveMaxSize(cfg *nodegroupconfigv1alpha1.AutoscalerNodeGroupConfig, annotations map[string]string) (int, error) {
+	if cfg != nil && cfg.Spec.MaxReplicas != nil {
+		max := int(*cfg.Spec.MaxReplicas)
+		if max < 0 {
+			return 0, errInvalidMaxAnnotation
+		}
+		return max, nil
+	}
+
+	max, err := maxSize(annotations)
+	if err != nil && err != errMissingMaxAnnotation {
+		return 0, err
+	}
+	if max < 0 {
+		return 0, errInvalidMaxAnnotation
+	}
+	return max, nil
+}
+
 func getOwnerForKind(u *unstructured.Unstructured, kind string) *metav1.OwnerReference {
 	for _, ref := range u.GetOwnerReferences() {
 		if ref.Kind == kind && ref.Name != "" {
@@ -148,35 +306,63 @@ func machineSetHasMachineDeploymentOwnerRef(machineSet *unstructured.Unstructure
 	return machineSetOwnerRef(machineSet) != nil
 }
 
-// normalizedProviderString splits s on '/' returning everything after
-// the last '/'.
-func normalizedProviderString(s string) normalizedProviderID {
-	split := strings.Split(s, "/")
-	return normalizedProviderID(split[len(split)-1])
-}
+// scaleFromZeroEnabled reports whether the named MachineSet/
+// MachineDeployment in namespace has enough information to build a node
+// template from scratch. If an AutoscalerNodeGroupConfig targeting
+// namespace/kind/name is available from nodeGroupConfigLister and sets
+// ScaleFromZero, that value takes precedence; a config that leaves
+// ScaleFromZero nil (e.g. one created only to set Capacity/Labels/Taints)
+// falls through to annotation parsing instead of being treated as an
+// explicit false, regardless of whether the CPU and memory quantities
+// came from the legacy cpuKey/memoryKey annotations, the resource model,
+// or the extendedResourcesKey map.
+func scaleFromZeroEnabled(namespace, kind, name string, annotations map[string]string) bool {
+	cfg := resolveNodeGroupConfig(namespace, kind, name)
+	if cfg != nil && cfg.Spec.ScaleFromZero != nil {
+		return *cfg.Spec.ScaleFromZero
+	}
 
-func scaleFromZeroEnabled(annotations map[string]string) bool {
-	cpu := annotations[cpuKey]
-	mem := annotations[memoryKey]
+	cpu, err := parseCPUCapacity(cfg, annotations)
+	if err != nil {
+		return false
+	}
 
-	if cpu != "" && mem != "" {
-		return true
+	mem, err := parseMemoryCapacity(cfg, annotations)
+	if err != nil {
+		return false
 	}
-	return false
+
+	return !cpu.IsZero() && !mem.IsZero()
 }
 
-func parseKey(annotations map[string]string, key string) (resource.Quantity, error) {
-	if val, exists := annotations[key]; exists && val != "" {
-		return resource.ParseQuantity(val)
+// resolveCapacityField returns cfg.Spec.Capacity[resourceName] if cfg
+// targets this group and sets it, so that a config created to pin
+// capacity takes precedence over the legacy annotations the same way
+// resolveMinSize/resolveMaxSize let MinReplicas/MaxReplicas take
+// precedence over theirs.
+func resolveCapacityField(cfg *nodegroupconfigv1alpha1.AutoscalerNodeGroupConfig, resourceName apiv1.ResourceName) (resource.Quantity, bool) {
+	if cfg == nil || cfg.Spec.Capacity == nil {
+		return resource.Quantity{}, false
 	}
-	return zeroQuantity.DeepCopy(), nil
+	q, ok := cfg.Spec.Capacity[resourceName]
+	return q, ok
 }
 
-func parseCPUCapacity(annotations map[string]string) (resource.Quantity, error) {
-	return parseKey(annotations, cpuKey)
+func parseCPUCapacity(cfg *nodegroupconfigv1alpha1.AutoscalerNodeGroupConfig, annotations map[string]string) (resource.Quantity, error) {
+	if q, ok := resolveCapacityField(cfg, apiv1.ResourceCPU); ok {
+		return q, nil
+	}
+	if val, exists := annotations[cpuKey]; exists && val != "" {
+		return resource.ParseQuantity(val)
+	}
+	return resolveCapacity(annotations, apiv1.ResourceCPU)
 }
 
-func parseMemoryCapacity(annotations map[string]string) (resource.Quantity, error) {
+func parseMemoryCapacity(cfg *nodegroupconfigv1alpha1.AutoscalerNodeGroupConfig, annotations map[string]string) (resource.Quantity, error) {
+	if q, ok := resolveCapacityField(cfg, apiv1.ResourceMemory); ok {
+		return q, nil
+	}
+
 	// The value for the memoryKey is expected to be an integer representing Mebibytes. e.g. "1024".
 	// https://www.iec.ch/si/binary.htm
 	val, exists := annotations[memoryKey]
@@ -188,15 +374,301 @@ func parseMemoryCapacity(annotations map[string]string) (resource.Quantity, erro
 		// Convert from Mebibytes to bytes
 		return *resource.NewQuantity(valInt*units.MiB, resource.DecimalSI), nil
 	}
+	return resolveCapacity(annotations, apiv1.ResourceMemory)
+}
+
+// resolveCapacity resolves resourceName from the resource model named by
+// resourceModelKey, falling back to the extendedResourcesKey map, once
+// the caller has already checked resourceName's own dedicated legacy
+// annotation key (cpuKey/memoryKey/gpuKey/maxPodsKey/ephemeralStorageKey)
+// and found it unset.
+func resolveCapacity(annotations map[string]string, resourceName apiv1.ResourceName) (resource.Quantity, error) {
+	q, err := resolveResourceModel(annotations, resourceName)
+	if err != nil {
+		return zeroQuantity.DeepCopy(), err
+	}
+	if !q.IsZero() {
+		return q, nil
+	}
+
+	resources, err := parseExtendedResources(annotations)
+	if err != nil {
+		return zeroQuantity.DeepCopy(), err
+	}
+	if q, ok := resources[resourceName]; ok {
+		return q, nil
+	}
+
 	return zeroQuantity.DeepCopy(), nil
 }
 
-func parseGPUCapacity(annotations map[string]string) (resource.Quantity, error) {
-	return parseKey(annotations, gpuKey)
+// gpuResourceName is the resource name a resource model tier's GPU range
+// resolves to, matching resourcemodel.Catalog.Resolve.
+const gpuResourceName = apiv1.ResourceName("nvidia.com/gpu")
+
+func parseGPUCapacity(cfg *nodegroupconfigv1alpha1.AutoscalerNodeGroupConfig, annotations map[string]string) (resource.Quantity, error) {
+	if q, ok := resolveCapacityField(cfg, gpuResourceName); ok {
+		return q, nil
+	}
+	if val, exists := annotations[gpuKey]; exists && val != "" {
+		return resource.ParseQuantity(val)
+	}
+	return resolveCapacity(annotations, gpuResourceName)
+}
+
+func parseMaxPodsCapacity(cfg *nodegroupconfigv1alpha1.AutoscalerNodeGroupConfig, annotations map[string]string) (resource.Quantity, error) {
+	if q, ok := resolveCapacityField(cfg, apiv1.ResourcePods); ok {
+		return q, nil
+	}
+	if val, exists := annotations[maxPodsKey]; exists && val != "" {
+		return resource.ParseQuantity(val)
+	}
+	return resolveCapacity(annotations, apiv1.ResourcePods)
+}
+
+func parseEphemeralStorageCapacity(cfg *nodegroupconfigv1alpha1.AutoscalerNodeGroupConfig, annotations map[string]string) (resource.Quantity, error) {
+	if q, ok := resolveCapacityField(cfg, apiv1.ResourceEphemeralStorage); ok {
+		return q, nil
+	}
+	if val, exists := annotations[ephemeralStorageKey]; exists && val != "" {
+		return resource.ParseQuantity(val)
+	}
+	return resolveCapacity(annotations, apiv1.ResourceEphemeralStorage)
+}
+
+// parseExtendedResources returns the resource-name to quantity map
+// encoded as a JSON/YAML object in the annotation keyed by
+// extendedResourcesKey, e.g. {"nvidia.com/mig-1g.5gb":"4","hugepages-2Mi":"2Gi"}.
+// Returns nil if the annotation is unset.
+func parseExtendedResources(annotations map[string]string) (apiv1.ResourceList, error) {
+	val, exists := annotations[extendedResourcesKey]
+	if !exists || val == "" {
+		return nil, nil
+	}
+
+	var raw map[string]string
+	if err := yaml.Unmarshal([]byte(val), &raw); err != nil {
+		return nil, fmt.Errorf("value %q from annotation %q is not a valid resource map: %v", val, extendedResourcesKey, err)
+	}
+
+	resources := make(apiv1.ResourceList, len(raw))
+	for name, quantity := range raw {
+		q, err := resource.ParseQuantity(quantity)
+		if err != nil {
+			return nil, fmt.Errorf("value %q for resource %q from annotation %q is not a valid quantity: %v", quantity, name, extendedResourcesKey, err)
+		}
+		resources[apiv1.ResourceName(name)] = q
+	}
+	return resources, nil
+}
+
+// parseNodeLabels returns cfg.Spec.Labels if cfg targets this group and
+// sets it, otherwise the labels encoded in the annotation keyed by
+// labelsKey, which takes a comma-separated list of key=value pairs, e.g.
+// "topology.kubernetes.io/zone=us-east-1a,node.kubernetes.io/instance-type=m5.xlarge".
+// Returns an empty, nil map if neither is set.
+func parseNodeLabels(cfg *nodegroupconfigv1alpha1.AutoscalerNodeGroupConfig, annotations map[string]string) (map[string]string, error) {
+	if cfg != nil && cfg.Spec.Labels != nil {
+		return cfg.Spec.Labels, nil
+	}
+
+	val, exists := annotations[labelsKey]
+	if !exists || val == "" {
+		return nil, nil
+	}
+
+	labels := make(map[string]string)
+	for _, pair := range strings.Split(val, ",") {
+		parts := strings.SplitN(pair, "=", 2)
+		if len(parts) != 2 || parts[0] == "" {
+			return nil, errors.Wrapf(errInvalidLabelsAnnotation, "%q", pair)
+		}
+		labels[parts[0]] = parts[1]
+	}
+	return labels, nil
+}
+
+// parseNodeTaints returns cfg.Spec.Taints if cfg targets this group and
+// sets it, otherwise the taints encoded in the annotation keyed by
+// taintsKey, which takes a comma-separated list of key=value:Effect
+// entries, e.g. "dedicated=gpu:NoSchedule,spot=true:PreferNoSchedule".
+// Returns nil if neither is set.
+func parseNodeTaints(cfg *nodegroupconfigv1alpha1.AutoscalerNodeGroupConfig, annotations map[string]string) ([]apiv1.Taint, error) {
+	if cfg != nil && cfg.Spec.Taints != nil {
+		return cfg.Spec.Taints, nil
+	}
+
+	val, exists := annotations[taintsKey]
+	if !exists || val == "" {
+		return nil, nil
+	}
+
+	var taints []apiv1.Taint
+	for _, entry := range strings.Split(val, ",") {
+		keyValue, effect, found := strings.Cut(entry, ":")
+		if !found {
+			return nil, errors.Wrapf(errInvalidTaintsAnnotation, "%q", entry)
+		}
+
+		switch apiv1.TaintEffect(effect) {
+		case apiv1.TaintEffectNoSchedule, apiv1.TaintEffectPreferNoSchedule, apiv1.TaintEffectNoExecute:
+		default:
+			return nil, errors.Wrapf(errInvalidTaintsAnnotation, "%q", entry)
+		}
+
+		parts := strings.SplitN(keyValue, "=", 2)
+		if len(parts) != 2 || parts[0] == "" {
+			return nil, errors.Wrapf(errInvalidTaintsAnnotation, "%q", entry)
+		}
+
+		taints = append(taints, apiv1.Taint{
+			Key:    parts[0],
+			Value:  parts[1],
+			Effect: apiv1.TaintEffect(effect),
+		})
+	}
+	return taints, nil
 }
 
-func parseMaxPodsCapacity(annotations map[string]string) (resource.Quantity, error) {
-	return parseKey(annotations, maxPodsKey)
+// parseArchitecture returns the CPU architecture encoded in the
+// annotation keyed by archKey (e.g. "amd64", "arm64"), or "" if unset.
+func parseArchitecture(annotations map[string]string) string {
+	return annotations[archKey]
+}
+
+// parseOperatingSystem returns the operating system encoded in the
+// annotation keyed by osKey (e.g. "linux", "windows"), or "" if unset.
+func parseOperatingSystem(annotations map[string]string) string {
+	return annotations[osKey]
+}
+
+// parseTopologyZone returns the topology zone encoded in the annotation
+// keyed by zoneKey, or "" if unset.
+func parseTopologyZone(annotations map[string]string) string {
+	return annotations[zoneKey]
+}
+
+// parseTopologyRegion returns the topology region encoded in the
+// annotation keyed by regionKey, or "" if unset.
+func parseTopologyRegion(annotations map[string]string) string {
+	return annotations[regionKey]
+}
+
+// NodeTemplateAttributes aggregates the labels, taints, architecture, OS
+// and topology information that supplement a scale-from-zero node
+// template's capacity.
+type NodeTemplateAttributes struct {
+	Labels          map[string]string
+	Taints          []apiv1.Taint
+	Architecture    string
+	OperatingSystem string
+	Zone            string
+	Region          string
+}
+
+// parseNodeTemplateAttributes parses the labelsKey, taintsKey, archKey,
+// osKey, zoneKey and regionKey annotations and aggregates them for the
+// code that builds the node group's *schedulerframework.NodeInfo, so
+// that scale-from-zero simulation can account for nodeSelectors,
+// tolerations and topology constraints in addition to capacity. If an
+// AutoscalerNodeGroupConfig targeting namespace/kind/name is available
+// from nodeGroupConfigLister, its typed Labels/Taints each take
+// precedence over the corresponding annotation when set.
+func parseNodeTemplateAttributes(namespace, kind, name string, annotations map[string]string) (*NodeTemplateAttributes, error) {
+	cfg := resolveNodeGroupConfig(namespace, kind, name)
+
+	labels, err := parseNodeLabels(cfg, annotations)
+	if err != nil {
+		return nil, err
+	}
+
+	taints, err := parseNodeTaints(cfg, annotations)
+	if err != nil {
+		return nil, err
+	}
+
+	return &NodeTemplateAttributes{
+		Labels:          labels,
+		Taints:          taints,
+		Architecture:    parseArchitecture(annotations),
+		OperatingSystem: parseOperatingSystem(annotations),
+		Zone:            parseTopologyZone(annotations),
+		Region:          parseTopologyRegion(annotations),
+	}, nil
+}
+
+// parseAcceptedPriorityClasses returns the pod PriorityClassNames a
+// MachineSet/MachineDeployment is willing to serve, as declared by the
+// priorityClassNamesKey annotation. Returns nil if the annotation is
+// unset, which the expander/filter should treat as accepting any
+// priority class.
+func parseAcceptedPriorityClasses(annotations map[string]string) []string {
+	val, exists := annotations[priorityClassNamesKey]
+	if !exists || val == "" {
+		return nil
+	}
+
+	var names []string
+	for _, name := range strings.Split(val, ",") {
+		name = strings.TrimSpace(name)
+		if name == "" {
+			continue
+		}
+		names = append(names, name)
+	}
+	return names
+}
+
+// parseAcceptedQoSClasses returns the pod QoS classes a MachineSet/
+// MachineDeployment is willing to serve, as declared by the qosClassKey
+// annotation. Returns nil if the annotation is unset, which the
+// expander/filter should treat as accepting any QoS class.
+func parseAcceptedQoSClasses(annotations map[string]string) ([]apiv1.PodQOSClass, error) {
+	val, exists := annotations[qosClassKey]
+	if !exists || val == "" {
+		return nil, nil
+	}
+
+	var classes []apiv1.PodQOSClass
+	for _, name := range strings.Split(val, ",") {
+		name = strings.TrimSpace(name)
+		if name == "" {
+			continue
+		}
+
+		class := apiv1.PodQOSClass(name)
+		switch class {
+		case apiv1.PodQOSGuaranteed, apiv1.PodQOSBurstable, apiv1.PodQOSBestEffort:
+			classes = append(classes, class)
+		default:
+			return nil, errors.Wrapf(errInvalidQoSClassAnnotation, "%q", name)
+		}
+	}
+	return classes, nil
+}
+
+// AcceptedWorkloadHints aggregates the priority-class and QoS hints a
+// MachineSet/MachineDeployment declares it is willing to serve.
+type AcceptedWorkloadHints struct {
+	PriorityClassNames []string
+	QoSClasses         []apiv1.PodQOSClass
+}
+
+// parseAcceptedWorkloadHints parses the priorityClassNamesKey and
+// qosClassKey annotations and aggregates them for the NodeGroup
+// constructor to expose alongside MinSize/MaxSize, so the expander/filter
+// can prefer or restrict scale-up of a group for pods whose
+// PriorityClassName or computed QoS class is in the accepted set.
+func parseAcceptedWorkloadHints(annotations map[string]string) (*AcceptedWorkloadHints, error) {
+	qosClasses, err := parseAcceptedQoSClasses(annotations)
+	if err != nil {
+		return nil, err
+	}
+
+	return &AcceptedWorkloadHints{
+		PriorityClassNames: parseAcceptedPriorityClasses(annotations),
+		QoSClasses:         qosClasses,
+	}, nil
 }
 
 func clusterNameFromResource(r *unstructured.Unstructured) string {
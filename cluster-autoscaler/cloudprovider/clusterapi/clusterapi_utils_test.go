@@ -0,0 +1,497 @@
+/*
+Copyright 2026 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package clusterapi
+
+import (
+	"testing"
+
+	apiv1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/resource"
+	nodegroupconfigv1alpha1 "k8s.io/autoscaler/cluster-autoscaler/cloudprovider/clusterapi/api/v1alpha1"
+	"k8s.io/autoscaler/cluster-autoscaler/cloudprovider/clusterapi/resourcemodel"
+)
+
+const testModelCatalogYAML = `
+tiers:
+- name: small
+  cpu:
+    min: "2"
+    max: "4"
+  memory:
+    min: "4Gi"
+    max: "8Gi"
+`
+
+func withTestResourceModelCatalog(t *testing.T) {
+	t.Helper()
+
+	c, err := resourcemodel.NewCatalog([]byte(testModelCatalogYAML))
+	if err != nil {
+		t.Fatalf("resourcemodel.NewCatalog() returned error: %v", err)
+	}
+
+	old := resourceModelCatalog
+	SetResourceModelCatalog(c)
+	t.Cleanup(func() { SetResourceModelCatalog(old) })
+}
+
+func TestParseCPUCapacityPrefersExplicitAnnotationOverModel(t *testing.T) {
+	withTestResourceModelCatalog(t)
+
+	annotations := map[string]string{
+		cpuKey:           "6",
+		resourceModelKey: "small",
+	}
+
+	got, err := parseCPUCapacity(nil, annotations)
+	if err != nil {
+		t.Fatalf("parseCPUCapacity() returned error: %v", err)
+	}
+
+	if want := resource.MustParse("6"); got.Cmp(want) != 0 {
+		t.Errorf("parseCPUCapacity() = %v, want explicit annotation value %v", got, want)
+	}
+}
+
+func TestParseCPUCapacityFallsBackToModel(t *testing.T) {
+	withTestResourceModelCatalog(t)
+
+	annotations := map[string]string{
+		resourceModelKey: "small",
+	}
+
+	got, err := parseCPUCapacity(nil, annotations)
+	if err != nil {
+		t.Fatalf("parseCPUCapacity() returned error: %v", err)
+	}
+
+	if want := resource.MustParse("2"); got.Cmp(want) != 0 {
+		t.Errorf("parseCPUCapacity() = %v, want model lower bound %v", got, want)
+	}
+}
+
+func TestParseCPUCapacityUnknownModelReturnsError(t *testing.T) {
+	withTestResourceModelCatalog(t)
+
+	annotations := map[string]string{
+		resourceModelKey: "nonexistent",
+	}
+
+	if _, err := parseCPUCapacity(nil, annotations); err == nil {
+		t.Error("parseCPUCapacity() with an unregistered resource model returned no error")
+	}
+}
+
+// fakeNodeGroupConfigLister is an in-memory NodeGroupConfigLister keyed by
+// namespace/kind/name, for tests that exercise CRD-vs-annotation
+// precedence without a real informer cache.
+type fakeNodeGroupConfigLister map[string]*nodegroupconfigv1alpha1.AutoscalerNodeGroupConfig
+
+func fakeNodeGroupConfigKey(namespace, kind, name string) string {
+	return namespace + "/" + kind + "/" + name
+}
+
+func (f fakeNodeGroupConfigLister) Get(targetNamespace, targetKind, targetName string) (*nodegroupconfigv1alpha1.AutoscalerNodeGroupConfig, bool) {
+	cfg, ok := f[fakeNodeGroupConfigKey(targetNamespace, targetKind, targetName)]
+	return cfg, ok
+}
+
+func withTestNodeGroupConfigLister(t *testing.T, l fakeNodeGroupConfigLister) {
+	t.Helper()
+
+	old := nodeGroupConfigLister
+	SetNodeGroupConfigLister(l)
+	t.Cleanup(func() { SetNodeGroupConfigLister(old) })
+}
+
+func int32Ptr(i int32) *int32 { return &i }
+func boolPtr(b bool) *bool    { return &b }
+
+const (
+	testNamespace = "default"
+	testKind      = "MachineSet"
+	testName      = "workers"
+)
+
+func TestParseScalingBoundsPrefersCRDOverAnnotations(t *testing.T) {
+	withTestNodeGroupConfigLister(t, fakeNodeGroupConfigLister{
+		fakeNodeGroupConfigKey(testNamespace, testKind, testName): {
+			Spec: nodegroupconfigv1alpha1.AutoscalerNodeGroupConfigSpec{
+				MinReplicas: int32Ptr(3),
+				MaxReplicas: int32Ptr(10),
+			},
+		},
+	})
+
+	annotations := map[string]string{
+		nodeGroupMinSizeAnnotationKey: "1",
+		nodeGroupMaxSizeAnnotationKey: "5",
+	}
+
+	min, max, err := parseScalingBounds(testNamespace, testKind, testName, annotations)
+	if err != nil {
+		t.Fatalf("parseScalingBounds() returned error: %v", err)
+	}
+	if min != 3 || max != 10 {
+		t.Errorf("parseScalingBounds() = (%d, %d), want CRD bounds (3, 10)", min, max)
+	}
+}
+
+func TestParseScalingBoundsFallsBackPerFieldWhenCRDFieldUnset(t *testing.T) {
+	withTestNodeGroupConfigLister(t, fakeNodeGroupConfigLister{
+		fakeNodeGroupConfigKey(testNamespace, testKind, testName): {
+			Spec: nodegroupconfigv1alpha1.AutoscalerNodeGroupConfigSpec{
+				MinReplicas: int32Ptr(3),
+				// MaxReplicas left nil: must fall through to the annotation.
+			},
+		},
+	})
+
+	annotations := map[string]string{
+		nodeGroupMinSizeAnnotationKey: "1",
+		nodeGroupMaxSizeAnnotationKey: "5",
+	}
+
+	min, max, err := parseScalingBounds(testNamespace, testKind, testName, annotations)
+	if err != nil {
+		t.Fatalf("parseScalingBounds() returned error: %v", err)
+	}
+	if min != 3 || max != 5 {
+		t.Errorf("parseScalingBounds() = (%d, %d), want (3, 5) with max from annotation", min, max)
+	}
+}
+
+func TestScaleFromZeroEnabledPrefersCRDOverAnnotations(t *testing.T) {
+	withTestNodeGroupConfigLister(t, fakeNodeGroupConfigLister{
+		fakeNodeGroupConfigKey(testNamespace, testKind, testName): {
+			Spec: nodegroupconfigv1alpha1.AutoscalerNodeGroupConfigSpec{
+				ScaleFromZero: boolPtr(true),
+			},
+		},
+	})
+
+	// No capacity annotations at all, so annotation-based resolution
+	// would otherwise report false.
+	if !scaleFromZeroEnabled(testNamespace, testKind, testName, nil) {
+		t.Error("scaleFromZeroEnabled() = false, want true from CRD ScaleFromZero")
+	}
+}
+
+func TestScaleFromZeroEnabledFallsBackWhenCRDFieldUnset(t *testing.T) {
+	withTestNodeGroupConfigLister(t, fakeNodeGroupConfigLister{
+		fakeNodeGroupConfigKey(testNamespace, testKind, testName): {
+			Spec: nodegroupconfigv1alpha1.AutoscalerNodeGroupConfigSpec{
+				// Created only to set Labels; ScaleFromZero left nil.
+				Labels: map[string]string{"foo": "bar"},
+			},
+		},
+	})
+
+	annotations := map[string]string{
+		cpuKey:    "4",
+		memoryKey: "8192",
+	}
+
+	if !scaleFromZeroEnabled(testNamespace, testKind, testName, annotations) {
+		t.Error("scaleFromZeroEnabled() = false, want true from annotation-derived capacity")
+	}
+}
+
+func TestParseCPUCapacityPrefersCRDOverAnnotation(t *testing.T) {
+	cfg := &nodegroupconfigv1alpha1.AutoscalerNodeGroupConfig{
+		Spec: nodegroupconfigv1alpha1.AutoscalerNodeGroupConfigSpec{
+			Capacity: apiv1.ResourceList{
+				apiv1.ResourceCPU: resource.MustParse("8"),
+			},
+		},
+	}
+
+	annotations := map[string]string{cpuKey: "2"}
+
+	got, err := parseCPUCapacity(cfg, annotations)
+	if err != nil {
+		t.Fatalf("parseCPUCapacity() returned error: %v", err)
+	}
+	if want := resource.MustParse("8"); got.Cmp(want) != 0 {
+		t.Errorf("parseCPUCapacity() = %v, want CRD capacity value %v", got, want)
+	}
+}
+
+func TestParseNodeLabelsPrefersCRDOverAnnotation(t *testing.T) {
+	cfg := &nodegroupconfigv1alpha1.AutoscalerNodeGroupConfig{
+		Spec: nodegroupconfigv1alpha1.AutoscalerNodeGroupConfigSpec{
+			Labels: map[string]string{"from": "crd"},
+		},
+	}
+
+	annotations := map[string]string{labelsKey: "from=annotation"}
+
+	got, err := parseNodeLabels(cfg, annotations)
+	if err != nil {
+		t.Fatalf("parseNodeLabels() returned error: %v", err)
+	}
+	if got["from"] != "crd" {
+		t.Errorf("parseNodeLabels() = %v, want CRD labels to take precedence", got)
+	}
+}
+
+func TestParseNodeLabelsInvalidPairReturnsError(t *testing.T) {
+	annotations := map[string]string{labelsKey: "missing-value"}
+
+	if _, err := parseNodeLabels(nil, annotations); err == nil {
+		t.Error("parseNodeLabels() with a key missing '=value' returned no error")
+	}
+}
+
+func TestParseNodeLabelsEmptyKeyReturnsError(t *testing.T) {
+	annotations := map[string]string{labelsKey: "=value"}
+
+	if _, err := parseNodeLabels(nil, annotations); err == nil {
+		t.Error("parseNodeLabels() with an empty key returned no error")
+	}
+}
+
+func TestParseNodeTaintsValidEntryParsed(t *testing.T) {
+	annotations := map[string]string{taintsKey: "dedicated=gpu:NoSchedule"}
+
+	got, err := parseNodeTaints(nil, annotations)
+	if err != nil {
+		t.Fatalf("parseNodeTaints() returned error: %v", err)
+	}
+	if len(got) != 1 || got[0].Key != "dedicated" || got[0].Value != "gpu" || got[0].Effect != apiv1.TaintEffectNoSchedule {
+		t.Errorf("parseNodeTaints() = %+v, want a single dedicated=gpu:NoSchedule taint", got)
+	}
+}
+
+func TestParseNodeTaintsMissingEffectReturnsError(t *testing.T) {
+	annotations := map[string]string{taintsKey: "dedicated=gpu"}
+
+	if _, err := parseNodeTaints(nil, annotations); err == nil {
+		t.Error("parseNodeTaints() with no ':Effect' suffix returned no error")
+	}
+}
+
+func TestParseNodeTaintsInvalidEffectReturnsError(t *testing.T) {
+	annotations := map[string]string{taintsKey: "dedicated=gpu:NotARealEffect"}
+
+	if _, err := parseNodeTaints(nil, annotations); err == nil {
+		t.Error("parseNodeTaints() with an unrecognized effect returned no error")
+	}
+}
+
+func TestParseNodeTemplateAttributesAggregatesAllFields(t *testing.T) {
+	annotations := map[string]string{
+		labelsKey: "topology.kubernetes.io/zone=us-east-1a",
+		taintsKey: "spot=true:PreferNoSchedule",
+		archKey:   "arm64",
+		osKey:     "linux",
+		zoneKey:   "us-east-1a",
+		regionKey: "us-east-1",
+	}
+
+	got, err := parseNodeTemplateAttributes(testNamespace, testKind, testName, annotations)
+	if err != nil {
+		t.Fatalf("parseNodeTemplateAttributes() returned error: %v", err)
+	}
+
+	if got.Architecture != "arm64" || got.OperatingSystem != "linux" || got.Zone != "us-east-1a" || got.Region != "us-east-1" {
+		t.Errorf("parseNodeTemplateAttributes() = %+v, want arch/os/zone/region from annotations", got)
+	}
+	if len(got.Labels) != 1 || len(got.Taints) != 1 {
+		t.Errorf("parseNodeTemplateAttributes() = %+v, want one label and one taint", got)
+	}
+}
+
+func TestParseNodeTemplateAttributesPropagatesLabelsError(t *testing.T) {
+	annotations := map[string]string{labelsKey: "missing-value"}
+
+	if _, err := parseNodeTemplateAttributes(testNamespace, testKind, testName, annotations); err == nil {
+		t.Error("parseNodeTemplateAttributes() with an invalid labelsKey annotation returned no error")
+	}
+}
+
+const testModelCatalogYAMLWithGPU = `
+tiers:
+- name: gpu-tier
+  cpu:
+    min: "8"
+    max: "16"
+  memory:
+    min: "32Gi"
+    max: "64Gi"
+  gpu:
+    min: "1"
+    max: "1"
+  maxPods:
+    min: "64"
+    max: "64"
+  ephemeralStorage:
+    min: "100Gi"
+    max: "200Gi"
+`
+
+func withTestGPUResourceModelCatalog(t *testing.T) {
+	t.Helper()
+
+	c, err := resourcemodel.NewCatalog([]byte(testModelCatalogYAMLWithGPU))
+	if err != nil {
+		t.Fatalf("resourcemodel.NewCatalog() returned error: %v", err)
+	}
+
+	old := resourceModelCatalog
+	SetResourceModelCatalog(c)
+	t.Cleanup(func() { SetResourceModelCatalog(old) })
+}
+
+func TestParseGPUCapacityFallsBackToModel(t *testing.T) {
+	withTestGPUResourceModelCatalog(t)
+
+	annotations := map[string]string{resourceModelKey: "gpu-tier"}
+
+	got, err := parseGPUCapacity(nil, annotations)
+	if err != nil {
+		t.Fatalf("parseGPUCapacity() returned error: %v", err)
+	}
+	if want := resource.MustParse("1"); got.Cmp(want) != 0 {
+		t.Errorf("parseGPUCapacity() = %v, want model GPU lower bound %v", got, want)
+	}
+}
+
+func TestParseMaxPodsCapacityFallsBackToModel(t *testing.T) {
+	withTestGPUResourceModelCatalog(t)
+
+	annotations := map[string]string{resourceModelKey: "gpu-tier"}
+
+	got, err := parseMaxPodsCapacity(nil, annotations)
+	if err != nil {
+		t.Fatalf("parseMaxPodsCapacity() returned error: %v", err)
+	}
+	if want := resource.MustParse("64"); got.Cmp(want) != 0 {
+		t.Errorf("parseMaxPodsCapacity() = %v, want model maxPods lower bound %v", got, want)
+	}
+}
+
+func TestParseEphemeralStorageCapacityFallsBackToModel(t *testing.T) {
+	withTestGPUResourceModelCatalog(t)
+
+	annotations := map[string]string{resourceModelKey: "gpu-tier"}
+
+	got, err := parseEphemeralStorageCapacity(nil, annotations)
+	if err != nil {
+		t.Fatalf("parseEphemeralStorageCapacity() returned error: %v", err)
+	}
+	if want := resource.MustParse("100Gi"); got.Cmp(want) != 0 {
+		t.Errorf("parseEphemeralStorageCapacity() = %v, want model ephemeralStorage lower bound %v", got, want)
+	}
+}
+
+func TestParseGPUCapacityFallsBackToExtendedResources(t *testing.T) {
+	annotations := map[string]string{
+		extendedResourcesKey: `nvidia.com/gpu: "2"`,
+	}
+
+	got, err := parseGPUCapacity(nil, annotations)
+	if err != nil {
+		t.Fatalf("parseGPUCapacity() returned error: %v", err)
+	}
+	if want := resource.MustParse("2"); got.Cmp(want) != 0 {
+		t.Errorf("parseGPUCapacity() = %v, want extended resources value %v", got, want)
+	}
+}
+
+func TestParseEphemeralStorageCapacityFallsBackToExtendedResources(t *testing.T) {
+	annotations := map[string]string{
+		extendedResourcesKey: `ephemeral-storage: "50Gi"`,
+	}
+
+	got, err := parseEphemeralStorageCapacity(nil, annotations)
+	if err != nil {
+		t.Fatalf("parseEphemeralStorageCapacity() returned error: %v", err)
+	}
+	if want := resource.MustParse("50Gi"); got.Cmp(want) != 0 {
+		t.Errorf("parseEphemeralStorageCapacity() = %v, want extended resources value %v", got, want)
+	}
+}
+
+func TestParseExtendedResourcesInvalidQuantityReturnsError(t *testing.T) {
+	annotations := map[string]string{
+		extendedResourcesKey: `nvidia.com/gpu: "not-a-quantity"`,
+	}
+
+	if _, err := parseGPUCapacity(nil, annotations); err == nil {
+		t.Error("parseGPUCapacity() with an invalid extended resources quantity returned no error")
+	}
+}
+
+func TestParseAcceptedPriorityClassesSkipsEmptyEntries(t *testing.T) {
+	annotations := map[string]string{priorityClassNamesKey: "high-priority,,batch, "}
+
+	got := parseAcceptedPriorityClasses(annotations)
+
+	want := []string{"high-priority", "batch"}
+	if len(got) != len(want) {
+		t.Fatalf("parseAcceptedPriorityClasses() = %v, want %v", got, want)
+	}
+	for i := range want {
+		if got[i] != want[i] {
+			t.Errorf("parseAcceptedPriorityClasses()[%d] = %q, want %q", i, got[i], want[i])
+		}
+	}
+}
+
+func TestParseAcceptedQoSClassesSkipsEmptyEntries(t *testing.T) {
+	annotations := map[string]string{qosClassKey: "Guaranteed,"}
+
+	got, err := parseAcceptedQoSClasses(annotations)
+	if err != nil {
+		t.Fatalf("parseAcceptedQoSClasses() returned error: %v", err)
+	}
+	if len(got) != 1 || got[0] != apiv1.PodQOSGuaranteed {
+		t.Errorf("parseAcceptedQoSClasses() = %v, want [%v]", got, apiv1.PodQOSGuaranteed)
+	}
+}
+
+func TestParseAcceptedQoSClassesInvalidClassReturnsError(t *testing.T) {
+	annotations := map[string]string{qosClassKey: "NotARealQoSClass"}
+
+	if _, err := parseAcceptedQoSClasses(annotations); err == nil {
+		t.Error("parseAcceptedQoSClasses() with an unrecognized class returned no error")
+	}
+}
+
+func TestParseAcceptedWorkloadHintsAggregatesBoth(t *testing.T) {
+	annotations := map[string]string{
+		priorityClassNamesKey: "high-priority,batch",
+		qosClassKey:           "Guaranteed,Burstable",
+	}
+
+	got, err := parseAcceptedWorkloadHints(annotations)
+	if err != nil {
+		t.Fatalf("parseAcceptedWorkloadHints() returned error: %v", err)
+	}
+	if len(got.PriorityClassNames) != 2 || len(got.QoSClasses) != 2 {
+		t.Errorf("parseAcceptedWorkloadHints() = %+v, want two priority classes and two QoS classes", got)
+	}
+}
+
+func TestParseAcceptedWorkloadHintsPropagatesQoSError(t *testing.T) {
+	annotations := map[string]string{qosClassKey: "NotARealQoSClass"}
+
+	if _, err := parseAcceptedWorkloadHints(annotations); err == nil {
+		t.Error("parseAcceptedWorkloadHints() with an invalid qos-class annotation returned no error")
+	}
+}
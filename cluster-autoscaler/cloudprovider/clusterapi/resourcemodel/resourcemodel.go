@@ -0,0 +1,106 @@
+/*
+Copyright 2026 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// Package resourcemodel provides a catalog of named, pre-registered
+// hardware resource tiers that the clusterapi provider can resolve a
+// single annotation against, instead of requiring raw capacity
+// annotations on every MachineSet or MachineDeployment.
+package resourcemodel
+
+import (
+	"fmt"
+
+	apiv1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/resource"
+	"sigs.k8s.io/yaml"
+)
+
+// ResourceRange describes the lower (Min) and upper (Max) bound of a
+// resource in a tier, in the style of Karmada's indexed ResourceModels
+// where tier N covers (2^(N-1), 2^N] for CPU and memory. Catalog.Resolve
+// takes Min as the tier's capacity; Max is neither read nor validated.
+type ResourceRange struct {
+	Min resource.Quantity `json:"min"`
+	Max resource.Quantity `json:"max"`
+}
+
+// Tier is a single named resource model entry in a Catalog.
+type Tier struct {
+	Name             string        `json:"name"`
+	CPU              ResourceRange `json:"cpu"`
+	Memory           ResourceRange `json:"memory"`
+	EphemeralStorage ResourceRange `json:"ephemeralStorage,omitempty"`
+	GPU              ResourceRange `json:"gpu,omitempty"`
+	MaxPods          ResourceRange `json:"maxPods,omitempty"`
+}
+
+// Catalog is the set of named resource Tiers loaded from a ConfigMap or
+// the --resource-model-config flag.
+type Catalog struct {
+	tiers map[string]Tier
+}
+
+// catalogFile is the on-disk/ConfigMap representation of a Catalog.
+type catalogFile struct {
+	Tiers []Tier `json:"tiers"`
+}
+
+// NewCatalog parses a YAML catalog document into a Catalog, keyed by
+// tier name for lookup. It returns an error if a tier is missing a name
+// or if two tiers declare the same name.
+func NewCatalog(data []byte) (*Catalog, error) {
+	var cf catalogFile
+	if err := yaml.Unmarshal(data, &cf); err != nil {
+		return nil, fmt.Errorf("failed to parse resource model catalog: %v", err)
+	}
+
+	c := &Catalog{tiers: make(map[string]Tier, len(cf.Tiers))}
+	for _, t := range cf.Tiers {
+		if t.Name == "" {
+			return nil, fmt.Errorf("resource model tier missing a name")
+		}
+		if _, exists := c.tiers[t.Name]; exists {
+			return nil, fmt.Errorf("duplicate resource model tier %q", t.Name)
+		}
+		c.tiers[t.Name] = t
+	}
+	return c, nil
+}
+
+// Resolve returns the capacity ResourceList for the named tier, filled in
+// from the conservative (lower) bound of each range the tier declares.
+// It returns an error if name is not a registered tier.
+func (c *Catalog) Resolve(name string) (apiv1.ResourceList, error) {
+	tier, ok := c.tiers[name]
+	if !ok {
+		return nil, fmt.Errorf("unknown resource model %q", name)
+	}
+
+	list := apiv1.ResourceList{
+		apiv1.ResourceCPU:    tier.CPU.Min,
+		apiv1.ResourceMemory: tier.Memory.Min,
+	}
+	if !tier.EphemeralStorage.Min.IsZero() {
+		list[apiv1.ResourceEphemeralStorage] = tier.EphemeralStorage.Min
+	}
+	if !tier.GPU.Min.IsZero() {
+		list["nvidia.com/gpu"] = tier.GPU.Min
+	}
+	if !tier.MaxPods.Min.IsZero() {
+		list[apiv1.ResourcePods] = tier.MaxPods.Min
+	}
+	return list, nil
+}
@@ -0,0 +1,141 @@
+/*
+Copyright 2026 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package resourcemodel
+
+import (
+	"testing"
+
+	apiv1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/resource"
+)
+
+const testCatalogYAML = `
+tiers:
+- name: small
+  cpu:
+    min: "2"
+    max: "4"
+  memory:
+    min: "4Gi"
+    max: "8Gi"
+- name: large
+  cpu:
+    min: "4"
+    max: "8"
+  memory:
+    min: "8Gi"
+    max: "16Gi"
+  gpu:
+    min: "1"
+    max: "1"
+  maxPods:
+    min: "110"
+    max: "110"
+`
+
+func TestResolveKnownTier(t *testing.T) {
+	c, err := NewCatalog([]byte(testCatalogYAML))
+	if err != nil {
+		t.Fatalf("NewCatalog() returned error: %v", err)
+	}
+
+	list, err := c.Resolve("large")
+	if err != nil {
+		t.Fatalf("Resolve(%q) returned error: %v", "large", err)
+	}
+
+	cases := []struct {
+		name string
+		got  resource.Quantity
+		want resource.Quantity
+	}{
+		{"cpu", list[apiv1.ResourceCPU], resource.MustParse("4")},
+		{"memory", list[apiv1.ResourceMemory], resource.MustParse("8Gi")},
+		{"gpu", list["nvidia.com/gpu"], resource.MustParse("1")},
+	}
+	for _, c := range cases {
+		if c.got.Cmp(c.want) != 0 {
+			t.Errorf("%s = %v, want %v", c.name, c.got, c.want)
+		}
+	}
+}
+
+func TestResolveUnknownTierReturnsError(t *testing.T) {
+	c, err := NewCatalog([]byte(testCatalogYAML))
+	if err != nil {
+		t.Fatalf("NewCatalog() returned error: %v", err)
+	}
+
+	if _, err := c.Resolve("nonexistent"); err == nil {
+		t.Error("Resolve() for an unregistered tier name returned no error")
+	}
+}
+
+func TestOverlappingTierRangesAreResolvedByName(t *testing.T) {
+	// Tiers are looked up by name, not validated against each other, so
+	// two tiers whose ranges overlap both load and resolve independently.
+	const overlapping = `
+tiers:
+- name: a
+  cpu:
+    min: "2"
+    max: "8"
+  memory:
+    min: "4Gi"
+    max: "16Gi"
+- name: b
+  cpu:
+    min: "4"
+    max: "8"
+  memory:
+    min: "8Gi"
+    max: "16Gi"
+`
+	c, err := NewCatalog([]byte(overlapping))
+	if err != nil {
+		t.Fatalf("NewCatalog() returned error for overlapping tiers: %v", err)
+	}
+
+	for _, name := range []string{"a", "b"} {
+		if _, err := c.Resolve(name); err != nil {
+			t.Errorf("Resolve(%q) returned error: %v", name, err)
+		}
+	}
+}
+
+func TestNewCatalogRejectsDuplicateNames(t *testing.T) {
+	const dup = `
+tiers:
+- name: small
+  cpu:
+    min: "2"
+    max: "4"
+  memory:
+    min: "4Gi"
+    max: "8Gi"
+- name: small
+  cpu:
+    min: "8"
+    max: "16"
+  memory:
+    min: "16Gi"
+    max: "32Gi"
+`
+	if _, err := NewCatalog([]byte(dup)); err == nil {
+		t.Error("NewCatalog() with duplicate tier names returned no error")
+	}
+}